@@ -1,12 +1,9 @@
 package t67xx
 
 import (
-	"encoding/binary"
 	"fmt"
 	"strings"
 	"time"
-
-	"golang.org/x/exp/io/i2c"
 )
 
 const (
@@ -20,6 +17,22 @@ const (
 	//    a data rate of 100kbps. The suggested delay of 10 milliseconds should be
 	//    adequate for almost all conceivable cases
 	commandSleep = 10 * time.Millisecond
+
+	// defaultAddressChangeDelay is how long SetAddress waits after writing
+	// the new address, and again after resetting, for the sensor to settle
+	// before the next command is sent. Overridden by T67XX.addressChangeDelay.
+	defaultAddressChangeDelay = time.Second
+)
+
+// Register and coil addresses used by the commands below, taken from the
+// datasheet's Modbus map.
+const (
+	registerFirmwareVersion = 0x1389
+	registerGasPPM          = 0x138b
+	registerStatus          = 0x138a
+	coilReset               = 0x03e8
+	coilABC                 = 0x03ee
+	registerAddress         = 0x0fa5
 )
 
 var (
@@ -44,8 +57,16 @@ type Logger interface {
 
 // T67XX encapsulates communications with the T67XX CO₂ sensor
 type T67XX struct {
-	Device *i2c.Device
+	Device Bus
 	log    Logger
+
+	address                 byte
+	commandDelay            time.Duration
+	calibrationPollInterval time.Duration
+	addressChangeDelay      time.Duration
+	warmupSource            WarmupSource
+	warmupStart             time.Time
+	clock                   func() time.Time
 }
 
 // SetLogger sets the logger to use
@@ -53,73 +74,64 @@ func (t *T67XX) SetLogger(l Logger) {
 	t.log = l
 }
 
-// FirmwareVersion returns the a sensors firmware
-func (t *T67XX) FirmwareVersion() (int, error) {
-	// Write the command
-	if err := t.Device.Write([]byte{0x04, 0x13, 0x89, 0x00, 0x01}); err != nil {
-		return 0, err
+// Address returns the I2C address the driver believes the sensor is
+// currently responding on: the value passed to WithAddress, updated by a
+// successful SetAddress call, or zero if neither has happened.
+func (t *T67XX) Address() byte {
+	return t.address
+}
+
+func (t *T67XX) frame() *frame {
+	delay := t.commandDelay
+	if delay <= 0 {
+		delay = commandSleep
 	}
 
-	time.Sleep(commandSleep)
+	return &frame{bus: t.Device, delay: delay, onSuccess: t.recordActivity}
+}
 
-	// Read the sensor data
-	b := make([]byte, 4)
-	if err := t.Device.Read(b); err != nil {
-		return 0, err
+// FirmwareVersion returns the sensor's firmware version as major, minor.
+func (t *T67XX) FirmwareVersion() (major int, minor int, err error) {
+	data, err := t.frame().readInputRegisters(registerFirmwareVersion, 1)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	t.log.Debugf("Read firmware version bytes: %v", b)
-	t.log.Debugf("Raw firmware version bytes: % 08b", b)
+	t.log.Debugf("Read firmware version bytes: %v", data)
 
-	return 1, nil
+	return int(data[0]), int(data[1]), nil
 }
 
 // GasPPM returns the CO₂ parts per million measured on the sensor
 func (t *T67XX) GasPPM() (int, error) {
-	// Write the command
-	if err := t.Device.Write([]byte{0x04, 0x13, 0x8b, 0x00, 0x01}); err != nil {
+	data, err := t.frame().readInputRegisters(registerGasPPM, 1)
+	if err != nil {
 		return 0, err
 	}
 
-	time.Sleep(10 * time.Millisecond)
-
-	// Read the sensor data
-	b := make([]byte, 4)
-	if err := t.Device.Read(b); err != nil {
-		return 0, err
-	}
-
-	return int(b[2])*256 + int(b[3]), nil
+	return int(data[0])*256 + int(data[1]), nil
 }
 
 // PrintStatus prints the status of the sensor
 func (t *T67XX) PrintStatus() error {
-	// Write the command
-	if err := t.Device.Write([]byte{0x04, 0x13, 0x8a, 0x00}); err != nil {
+	status, err := t.Status()
+	if err != nil {
 		return err
 	}
 
-	time.Sleep(commandSleep)
-
-	// Read the sensor data
-	b := make([]byte, 2)
-	if err := t.Device.Read(b); err != nil {
-		return err
-	}
-
-	t.log.Debugf("Read status bytes: %v\n", b)
-	t.log.Debugf("Raw status bytes: % 08b\n", b)
-	fmt.Printf("Status bits set: %s", strings.Join(Bitmask(binary.BigEndian.Uint16(b)).ListDescriptions(statusBitData), ", "))
+	fmt.Printf("Status bits set: %s", strings.Join(status.ListDescriptions(statusBitData), ", "))
 	return nil
 }
 
 // Reset resets the sensor. You will need to make sure the sensor is available
-// before getting a new reading
+// before getting a new reading. Resetting restarts the sensor's warm-up
+// period, so it also resets the driver's WarmupSourceDevice clock.
 func (t *T67XX) Reset() error {
-	if err := t.Device.Write([]byte{0x05, 0x03, 0xe8, 0xff, 0x00}); err != nil {
+	if err := t.frame().writeSingleCoil(coilReset, 0xff00); err != nil {
 		return err
 	}
 
+	t.warmupStart = t.now()
 	return nil
 }
 
@@ -141,39 +153,33 @@ func (t *T67XX) Reset() error {
 //    Note: Applies when used in typical residential ambient air. Consult Telaire
 //    if other gases or corrosive agents are part of the application environment."
 func (t *T67XX) EnableABC() error {
-	// Write the command
-	if err := t.Device.Write([]byte{0x05, 0x03, 0xee, 0xff, 0x00}); err != nil {
-		return err
-	}
-
-	return nil
+	return t.frame().writeSingleCoil(coilABC, 0xff00)
 }
 
-// def calibrate(self):
-// buffer = array.array('B', [0x05, 0x03, 0xec, 0xff, 0x00])
-// self.dev.write(buffer)
-// time.sleep(0.1)
-// data = self.dev.read(5)
-// buffer = array.array('B', data)
-// return buffer[3]*256+buffer[3]
-
 // SetAddress sets the i2c address of the sensor
 func (t *T67XX) SetAddress(address byte) error {
 	if address < 0x03 || address > 0x77 {
-		t.log.Fatalf("Address should be in the range 0x03 -> 0x77, you requested address 0x%x", int(address))
+		return &ErrAddressOutOfRange{Address: address}
 	}
 
-	if err := t.Device.Write([]byte{0x06, 0x0f, 0xa5, 0x00, address}); err != nil {
+	delay := t.addressChangeDelay
+	if delay <= 0 {
+		delay = defaultAddressChangeDelay
+	}
+
+	if err := t.frame().writeSingleRegister(registerAddress, uint16(address)); err != nil {
 		return err
 	}
 
-	time.Sleep(time.Second)
+	time.Sleep(delay)
 
 	if err := t.Reset(); err != nil {
 		return err
 	}
 
-	time.Sleep(time.Second)
+	time.Sleep(delay)
+
+	t.address = address
 
 	return nil
 }
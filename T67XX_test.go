@@ -0,0 +1,169 @@
+package t67xx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wjessop/t67xx/t67xxtest"
+)
+
+type testLogger struct{}
+
+func (testLogger) Debug(...interface{})          {}
+func (testLogger) Debugf(string, ...interface{}) {}
+func (testLogger) Fatalf(string, ...interface{}) {}
+
+func newTestDriver(transactions []t67xxtest.Transaction) (*T67XX, *t67xxtest.Bus) {
+	bus := t67xxtest.New(transactions)
+	return &T67XX{Device: bus, log: testLogger{}}, bus
+}
+
+func TestFirmwareVersion(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x04, 0x13, 0x89, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x01, 0x05, 0x61, 0x47},
+		},
+	})
+
+	major, minor, err := driver.FirmwareVersion()
+	if err != nil {
+		t.Fatalf("FirmwareVersion returned an error: %v", err)
+	}
+	if major != 1 || minor != 5 {
+		t.Fatalf("expected firmware version 1.5, got %d.%d", major, minor)
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFirmwareVersionBadCRC(t *testing.T) {
+	driver, _ := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x04, 0x13, 0x89, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x01, 0x05, 0x00, 0x00}, // corrupt CRC
+		},
+	})
+
+	if _, _, err := driver.FirmwareVersion(); err == nil {
+		t.Fatal("expected a ProtocolError from a bad CRC, got nil")
+	} else if _, ok := err.(*ProtocolError); !ok {
+		t.Fatalf("expected a *ProtocolError, got %T: %v", err, err)
+	}
+}
+
+func TestFirmwareVersionWriteMismatch(t *testing.T) {
+	driver, _ := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x04, 0x13, 0x89, 0x00, 0x02}, // wrong register count
+			Read:  []byte{0x04, 0x02, 0x01, 0x05, 0x61, 0x47},
+		},
+	})
+
+	if _, _, err := driver.FirmwareVersion(); err == nil {
+		t.Fatal("expected an error from a mismatched write, got nil")
+	}
+}
+
+func TestGasPPM(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x04, 0x13, 0x8b, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x01, 0xf4, 0xa0, 0xc3},
+		},
+	})
+
+	ppm, err := driver.GasPPM()
+	if err != nil {
+		t.Fatalf("GasPPM returned an error: %v", err)
+	}
+	if ppm != 500 {
+		t.Fatalf("expected 500 ppm, got %d", ppm)
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGasPPMFunctionCodeMismatch(t *testing.T) {
+	driver, _ := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x04, 0x13, 0x8b, 0x00, 0x01},
+			Read:  []byte{0x03, 0x02, 0x01, 0xf4, 0x00, 0x00}, // wrong function code echo
+		},
+	})
+
+	if _, err := driver.GasPPM(); err == nil {
+		t.Fatal("expected a ProtocolError from a bad function code echo, got nil")
+	}
+}
+
+func TestPrintStatus(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x08, 0x00, 0xa7, 0x14},
+		},
+	})
+
+	if err := driver.PrintStatus(); err != nil {
+		t.Fatalf("PrintStatus returned an error: %v", err)
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReset(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x05, 0x03, 0xe8, 0xff, 0x00},
+			Read:  []byte{0x05, 0x03, 0xe8, 0xff, 0x00, 0x29, 0x80},
+		},
+	})
+
+	if err := driver.Reset(); err != nil {
+		t.Fatalf("Reset returned an error: %v", err)
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEnableABC(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x05, 0x03, 0xee, 0xff, 0x00},
+			Read:  []byte{0x05, 0x03, 0xee, 0xff, 0x00, 0xc9, 0x81},
+		},
+	})
+
+	if err := driver.EnableABC(); err != nil {
+		t.Fatalf("EnableABC returned an error: %v", err)
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetAddress(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x06, 0x0f, 0xa5, 0x00, 0x22},
+			Read:  []byte{0x06, 0x0f, 0xa5, 0x00, 0x22, 0x3f, 0x2e},
+		},
+		{
+			Write: []byte{0x05, 0x03, 0xe8, 0xff, 0x00},
+			Read:  []byte{0x05, 0x03, 0xe8, 0xff, 0x00, 0x29, 0x80},
+		},
+	})
+	driver.addressChangeDelay = time.Millisecond
+
+	if err := driver.SetAddress(0x22); err != nil {
+		t.Fatalf("SetAddress returned an error: %v", err)
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,42 @@
+package t67xx
+
+import (
+	"golang.org/x/exp/io/i2c"
+)
+
+// Bus is the minimal I2C transaction interface the driver needs. It exists so
+// that T67XX isn't hard-wired to golang.org/x/exp/io/i2c, letting callers
+// supply an adapter for whatever stack they're already using (periph.io,
+// tinygo.org/x/drivers, github.com/d2r2/go-i2c, a test double, ...).
+type Bus interface {
+	Read([]byte) error
+	Write([]byte) error
+	Close() error
+}
+
+// I2CExpBus adapts a *golang.org/x/exp/io/i2c.Device, the bus this driver
+// historically depended on directly, to the Bus interface.
+type I2CExpBus struct {
+	Device *i2c.Device
+}
+
+// NewI2CExpBus wraps an already-open x/exp/io/i2c device so it can be passed
+// to T67XX as a Bus.
+func NewI2CExpBus(device *i2c.Device) *I2CExpBus {
+	return &I2CExpBus{Device: device}
+}
+
+// Read implements Bus.
+func (b *I2CExpBus) Read(p []byte) error {
+	return b.Device.Read(p)
+}
+
+// Write implements Bus.
+func (b *I2CExpBus) Write(p []byte) error {
+	return b.Device.Write(p)
+}
+
+// Close implements Bus.
+func (b *I2CExpBus) Close() error {
+	return b.Device.Close()
+}
@@ -0,0 +1,103 @@
+package t67xx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	coilSinglePointCalibration = 0x03ec
+
+	// defaultCalibrationPollInterval is how often StartSinglePointCalibration
+	// polls the status register while T67XX.calibrationPollInterval is unset.
+	defaultCalibrationPollInterval = time.Second
+)
+
+// Status register bits relevant to calibration, named from statusBitData.
+const (
+	statusBitErrorCondition         = Bitmask(0x1)
+	statusBitFlashError             = Bitmask(0x2)
+	statusBitCalibrationError       = Bitmask(0x4)
+	statusBitSinglePointCalibration = Bitmask(0x8000)
+)
+
+// CalibrationError reports that a calibration command finished with one or
+// more error bits set in the sensor's status register, rather than the
+// calibration-in-progress bit simply clearing.
+type CalibrationError struct {
+	Status Bitmask
+}
+
+func (e *CalibrationError) Error() string {
+	return fmt.Sprintf("t67xx: calibration error, status bits set: %s", strings.Join(e.Status.ListDescriptions(statusBitData), ", "))
+}
+
+// Status returns the raw status register bits. See PrintStatus for a
+// human-readable rendering of the same data.
+func (t *T67XX) Status() (Bitmask, error) {
+	data, err := t.frame().readInputRegisters(registerStatus, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return Bitmask(uint16(data[0])<<8 | uint16(data[1])), nil
+}
+
+// DisableABC disables the ABC calibration enabled by EnableABC.
+func (t *T67XX) DisableABC() error {
+	return t.frame().writeSingleCoil(coilABC, 0x0000)
+}
+
+// ABCEnabled reports whether ABC calibration is currently enabled.
+func (t *T67XX) ABCEnabled() (bool, error) {
+	data, err := t.frame().readInputRegisters(coilABC, 1)
+	if err != nil {
+		return false, err
+	}
+
+	return data[0] != 0 || data[1] != 0, nil
+}
+
+// StartSinglePointCalibration runs a single-point calibration against a
+// known reference concentration. The sensor should be in fresh air at the
+// reference CO₂ level (400 ppm) before this is called.
+//
+// It blocks, polling the status register (once a second unless
+// WithCalibrationPollInterval set another interval), until the "Single
+// point calibration" bit clears, the sensor reports a flash or calibration
+// error (returned as a *CalibrationError), or ctx is done.
+func (t *T67XX) StartSinglePointCalibration(ctx context.Context) error {
+	if err := t.frame().writeSingleCoil(coilSinglePointCalibration, 0xff00); err != nil {
+		return err
+	}
+
+	interval := t.calibrationPollInterval
+	if interval <= 0 {
+		interval = defaultCalibrationPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			status, err := t.Status()
+			if err != nil {
+				return err
+			}
+
+			if status&(statusBitFlashError|statusBitCalibrationError) != 0 {
+				return &CalibrationError{Status: status}
+			}
+
+			if !status.IsSet(statusBitSinglePointCalibration) {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package t67xx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/wjessop/t67xx/t67xxtest"
+)
+
+func TestStatus(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x08, 0x00, 0xa7, 0x14},
+		},
+	})
+
+	status, err := driver.Status()
+	if err != nil {
+		t.Fatalf("Status returned an error: %v", err)
+	}
+	if !status.IsSet(0x800) {
+		t.Fatalf("expected the warm-up bit to be set in status 0x%04x", status)
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDisableABC(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x05, 0x03, 0xee, 0x00, 0x00},
+			Read:  []byte{0x05, 0x03, 0xee, 0x00, 0x00, 0x88, 0x71},
+		},
+	})
+
+	if err := driver.DisableABC(); err != nil {
+		t.Fatalf("DisableABC returned an error: %v", err)
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestABCEnabled(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x04, 0x03, 0xee, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x00, 0x01, 0x61, 0x14},
+		},
+	})
+
+	enabled, err := driver.ABCEnabled()
+	if err != nil {
+		t.Fatalf("ABCEnabled returned an error: %v", err)
+	}
+	if !enabled {
+		t.Fatal("expected ABC to be reported as enabled")
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStartSinglePointCalibration(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x05, 0x03, 0xec, 0xff, 0x00},
+			Read:  []byte{0x05, 0x03, 0xec, 0xff, 0x00, 0x68, 0x41},
+		},
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x80, 0x00, 0xc1, 0x14}, // still calibrating
+		},
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x00, 0x00, 0xa0, 0xd4}, // cleared
+		},
+	})
+	driver.calibrationPollInterval = time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := driver.StartSinglePointCalibration(ctx); err != nil {
+		t.Fatalf("StartSinglePointCalibration returned an error: %v", err)
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStartSinglePointCalibrationError(t *testing.T) {
+	driver, _ := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x05, 0x03, 0xec, 0xff, 0x00},
+			Read:  []byte{0x05, 0x03, 0xec, 0xff, 0x00, 0x68, 0x41},
+		},
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x00, 0x02, 0x21, 0x15}, // flash error bit set
+		},
+	})
+	driver.calibrationPollInterval = time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := driver.StartSinglePointCalibration(ctx)
+	if _, ok := err.(*CalibrationError); !ok {
+		t.Fatalf("expected a *CalibrationError, got %T: %v", err, err)
+	}
+}
+
+func TestStartSinglePointCalibrationCancel(t *testing.T) {
+	driver, _ := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x05, 0x03, 0xec, 0xff, 0x00},
+			Read:  []byte{0x05, 0x03, 0xec, 0xff, 0x00, 0x68, 0x41},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := driver.StartSinglePointCalibration(ctx); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
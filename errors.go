@@ -0,0 +1,13 @@
+package t67xx
+
+import "fmt"
+
+// ErrAddressOutOfRange indicates an I2C address outside the sensor's
+// supported 0x03-0x77 range was passed to WithAddress or SetAddress.
+type ErrAddressOutOfRange struct {
+	Address byte
+}
+
+func (e *ErrAddressOutOfRange) Error() string {
+	return fmt.Sprintf("t67xx: address 0x%x is out of range, must be between 0x03 and 0x77", e.Address)
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"log"
-	"os"
 
 	"github.com/wjessop/t67xx"
 	"golang.org/x/exp/io/i2c"
@@ -22,20 +21,12 @@ func main() {
 		log.Fatalf("Couldn't open the T67XX sensor at %x, error was %v", t67XXSensorAddress, err)
 	}
 
-	// Create the driver
-	driver := &t67xx.T67XX{}
-	driver.Device = device
-
-	// For now the library needs a logger to be provided. It needs to satisfy the
-	// following interface:
-	//
-	// type Logger interface {
-	// 	Debug(...interface{})
-	// 	Debugf(string, ...interface{})
-	// 	Fatalf(string, ...interface{})
-	// }
-	log := log.New(os.Stderr, "T67XX", log.LstdFlags)
-	driver.SetLogger(log)
+	// Create the driver. New's default logger writes through slog.Default();
+	// see t67xx.WithLogger / t67xx.WithSlog to override it.
+	driver, err := t67xx.New(t67xx.NewI2CExpBus(device))
+	if err != nil {
+		log.Fatalf("Couldn't create the T67XX driver: %v", err)
+	}
 
 	if err := driver.SetAddress(byte(t67XXSensorNewAddress)); err != nil {
 		log.Fatal(err)
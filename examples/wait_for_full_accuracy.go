@@ -1,9 +1,8 @@
 package main
 
 import (
+	"context"
 	"log"
-	"os"
-	"time"
 
 	"github.com/wjessop/t67xx"
 	"golang.org/x/exp/io/i2c"
@@ -22,20 +21,12 @@ func main() {
 		log.Fatalf("Couldn't open the T67XX sensor at %x, error was %v", t67XXSensorAddress, err)
 	}
 
-	// Create the driver
-	driver := &t67xx.T67XX{}
-	driver.Device = device
-
-	// For now the library needs a logger to be provided. It needs to satisfy the
-	// following interface:
-	//
-	// type Logger interface {
-	// 	Debug(...interface{})
-	// 	Debugf(string, ...interface{})
-	// 	Fatalf(string, ...interface{})
-	// }
-	log := log.New(os.Stderr, "T67XX", log.LstdFlags)
-	driver.SetLogger(log)
+	// Create the driver. New's default logger writes through slog.Default();
+	// see t67xx.WithLogger / t67xx.WithSlog to override it.
+	driver, err := t67xx.New(t67xx.NewI2CExpBus(device))
+	if err != nil {
+		log.Fatalf("Couldn't create the T67XX driver: %v", err)
+	}
 
 	// EnableABC enables the ABC calibration. From the datasheet:
 	//
@@ -55,49 +46,29 @@ func main() {
 	//    Note: Applies when used in typical residential ambient air. Consult Telaire
 	//    if other gases or corrosive agents are part of the application environment."
 	if err := driver.EnableABC(); err != nil {
-		log.Fatal("Could not enable ABC calibration on the sensor", err)
+		log.Fatalf("Could not enable ABC calibration on the sensor: %v", err)
 	}
 
-	// Create a signal channel that will be closed when the sensor reaches full accuracy
-	accuracyChan := make(chan interface{})
-
-	go func(driver *t67xx.T67XX) {
-		// Sleep in the background until the sensor has been powered up long enough
-		// to achieve full accuracy.
-		err := driver.SleepUntilFullAccuracy()
-		if err != nil {
-			log.Fatal("Error sleeping until full accuracy", err)
-		}
-
-		// Close the signal channel then exit the goroutine as we no-longer need it.
-		close(accuracyChan)
-	}(driver)
-
-	// Now we can read the CO₂ readings in a loop, taking care to discard any
-	// spurious readings.
-	for {
-		select {
-		case <-accuracyChan:
-			// A successful read on the closed channel indicates that the sensor is
-			// now fully accurate.
-			co2Reading, err := driver.GasPPM()
-			if err != nil {
-				log.Fatal(err)
-			}
+	// Stream CO₂ readings once a second. Readings taken before the sensor has
+	// finished warming up are tagged FlagWarmup rather than suppressed, and
+	// readings outside the plausible range are tagged FlagOutlier instead of
+	// being discarded outright.
+	readings, err := driver.Stream(context.Background(), t67xx.StreamOptions{
+		MinPPM: 200,
+		MaxPPM: 5000,
+	})
+	if err != nil {
+		log.Fatalf("Could not start streaming readings from the sensor: %v", err)
+	}
 
-			// The sensors I have sometimes give spurious readings. Let's discount them.
-			// Adjust these values based on the baseline CO₂ reading you expect. The max is
-			// the measurement limit according to the datasheet, but i've seen values well
-			// over 10,000.
-			if co2Reading > 5000 || co2Reading < 200 {
-				log.Printf("Reading of %d from CO₂ sensor was out of allowed bounds", co2Reading)
-			} else {
-				log.Printf("Got CO₂ reading of %d from CO₂ sensor", co2Reading)
-			}
+	for reading := range readings {
+		switch {
+		case reading.Flags.HasFlag(t67xx.FlagWarmup):
+			log.Printf("Skipping CO₂ reading of %d as the sensor has not yet achieved full accuracy", reading.PPM)
+		case reading.Flags.HasFlag(t67xx.FlagOutlier):
+			log.Printf("Reading of %d from CO₂ sensor was out of allowed bounds", reading.PPM)
 		default:
-			log.Print("Skipping CO₂ reading as the sensor has not yet achieved full accuracy")
+			log.Printf("Got CO₂ reading of %d from CO₂ sensor", reading.PPM)
 		}
-
-		time.Sleep(10)
 	}
 }
@@ -0,0 +1,160 @@
+package t67xx
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// Modbus function codes the T67XX understands.
+const (
+	functionReadInputRegisters  = 0x04
+	functionWriteSingleCoil     = 0x05
+	functionWriteSingleRegister = 0x06
+)
+
+// ProtocolError indicates that a response from the sensor failed validation:
+// an unexpected function-code echo, a payload length that didn't match the
+// request, or a failed CRC check. Any of these mean the exchange on the bus
+// was corrupted or spurious, as distinct from a genuine (if surprising) gas
+// reading.
+type ProtocolError struct {
+	Op  string
+	Msg string
+}
+
+func (e *ProtocolError) Error() string {
+	return fmt.Sprintf("t67xx: protocol error during %s: %s", e.Op, e.Msg)
+}
+
+// frame implements the Modbus-style request/response framing the T67XX
+// speaks over I2C: a five-byte request, a settling delay, then a response
+// that echoes the function code, carries either a byte count and register
+// data (reads) or the echoed address and value (writes), and ends with a
+// Modbus CRC-16.
+type frame struct {
+	bus Bus
+
+	// delay is how long to wait after writing a command before reading the
+	// sensor's response.
+	delay time.Duration
+
+	// onSuccess, if set, is called after each exchange that completes
+	// without error.
+	onSuccess func()
+}
+
+func (f *frame) succeeded() {
+	if f.onSuccess != nil {
+		f.onSuccess()
+	}
+}
+
+// readInputRegisters sends function code 0x04 for the given register address
+// and count, and returns the big-endian register data.
+func (f *frame) readInputRegisters(addr, n uint16) ([]byte, error) {
+	req := make([]byte, 5)
+	req[0] = functionReadInputRegisters
+	binary.BigEndian.PutUint16(req[1:3], addr)
+	binary.BigEndian.PutUint16(req[3:5], n)
+
+	if err := f.bus.Write(req); err != nil {
+		return nil, err
+	}
+
+	time.Sleep(f.delay)
+
+	byteCount := int(n) * 2
+	resp := make([]byte, 2+byteCount+2)
+	if err := f.bus.Read(resp); err != nil {
+		return nil, err
+	}
+
+	if resp[0] != functionReadInputRegisters {
+		return nil, &ProtocolError{Op: "readInputRegisters", Msg: fmt.Sprintf("unexpected function code echo 0x%02x", resp[0])}
+	}
+	if int(resp[1]) != byteCount {
+		return nil, &ProtocolError{Op: "readInputRegisters", Msg: fmt.Sprintf("unexpected byte count %d, wanted %d", resp[1], byteCount)}
+	}
+	if err := checkCRC("readInputRegisters", resp); err != nil {
+		return nil, err
+	}
+
+	f.succeeded()
+	return resp[2 : 2+byteCount], nil
+}
+
+// writeSingleCoil sends function code 0x05 for the given coil address and
+// value, and validates the echoed response.
+func (f *frame) writeSingleCoil(addr, value uint16) error {
+	return f.writeCommand(functionWriteSingleCoil, addr, value)
+}
+
+// writeSingleRegister sends function code 0x06 for the given register
+// address and value, and validates the echoed response.
+func (f *frame) writeSingleRegister(addr, value uint16) error {
+	return f.writeCommand(functionWriteSingleRegister, addr, value)
+}
+
+func (f *frame) writeCommand(function byte, addr, value uint16) error {
+	req := make([]byte, 5)
+	req[0] = function
+	binary.BigEndian.PutUint16(req[1:3], addr)
+	binary.BigEndian.PutUint16(req[3:5], value)
+
+	if err := f.bus.Write(req); err != nil {
+		return err
+	}
+
+	time.Sleep(f.delay)
+
+	resp := make([]byte, 7)
+	if err := f.bus.Read(resp); err != nil {
+		return err
+	}
+
+	if resp[0] != function {
+		return &ProtocolError{Op: "writeCommand", Msg: fmt.Sprintf("unexpected function code echo 0x%02x", resp[0])}
+	}
+	if err := checkCRC("writeCommand", resp); err != nil {
+		return err
+	}
+
+	f.succeeded()
+	return nil
+}
+
+// checkCRC validates the trailing two bytes of frameBytes against the Modbus
+// CRC-16 (polynomial 0xA001, initial value 0xFFFF, reflected) of everything
+// preceding them.
+func checkCRC(op string, frameBytes []byte) error {
+	if len(frameBytes) < 2 {
+		return &ProtocolError{Op: op, Msg: "response too short to contain a CRC"}
+	}
+
+	payload := frameBytes[:len(frameBytes)-2]
+	want := crc16(payload)
+	got := binary.LittleEndian.Uint16(frameBytes[len(frameBytes)-2:])
+	if want != got {
+		return &ProtocolError{Op: op, Msg: fmt.Sprintf("CRC mismatch: computed 0x%04x, got 0x%04x", want, got)}
+	}
+
+	return nil
+}
+
+// crc16 computes the Modbus CRC-16: polynomial 0xA001, initial value 0xFFFF,
+// reflected input/output, transmitted low byte first.
+func crc16(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b)
+		for i := 0; i < 8; i++ {
+			if crc&0x0001 != 0 {
+				crc = (crc >> 1) ^ 0xA001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,25 @@
+package t67xx
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// slogLogger adapts a *slog.Logger, the default logging interface for
+// drivers constructed with New, to the legacy Logger interface used
+// throughout this package.
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+func (l slogLogger) Debug(args ...interface{}) {
+	l.logger.Debug(fmt.Sprint(args...))
+}
+
+func (l slogLogger) Debugf(format string, args ...interface{}) {
+	l.logger.Debug(fmt.Sprintf(format, args...))
+}
+
+func (l slogLogger) Fatalf(format string, args ...interface{}) {
+	l.logger.Error(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,105 @@
+package t67xx
+
+import (
+	"log/slog"
+	"time"
+)
+
+// Option configures a T67XX constructed with New.
+type Option func(*T67XX) error
+
+// WithLogger sets the logger the driver uses. Anything satisfying the
+// legacy Logger interface (Debug/Debugf/Fatalf) is accepted; see WithSlog
+// for the default log/slog-based logger.
+func WithLogger(l Logger) Option {
+	return func(t *T67XX) error {
+		t.log = l
+		return nil
+	}
+}
+
+// WithSlog sets the driver's logger from a *slog.Logger.
+func WithSlog(l *slog.Logger) Option {
+	return WithLogger(slogLogger{logger: l})
+}
+
+// WithAddress records the I2C address the sensor is already configured to
+// respond on. It only validates and stores the address; it does not talk to
+// the sensor. Use SetAddress to change the sensor's address on the bus.
+func WithAddress(address byte) Option {
+	return func(t *T67XX) error {
+		if address < 0x03 || address > 0x77 {
+			return &ErrAddressOutOfRange{Address: address}
+		}
+		t.address = address
+		return nil
+	}
+}
+
+// WithCommandDelay overrides how long the driver waits after writing a
+// command before reading the sensor's response. Defaults to commandSleep.
+func WithCommandDelay(d time.Duration) Option {
+	return func(t *T67XX) error {
+		t.commandDelay = d
+		return nil
+	}
+}
+
+// WithCalibrationPollInterval overrides how often
+// StartSinglePointCalibration polls the status register while waiting for
+// calibration to finish. Defaults to defaultCalibrationPollInterval.
+// Intended mainly for tests; real calibrations take long enough that the
+// default poll rate is immaterial.
+func WithCalibrationPollInterval(d time.Duration) Option {
+	return func(t *T67XX) error {
+		t.calibrationPollInterval = d
+		return nil
+	}
+}
+
+// WithAddressChangeDelay overrides how long SetAddress waits for the sensor
+// to settle after writing the new address and after resetting. Defaults to
+// defaultAddressChangeDelay. Intended mainly for tests; a real address
+// change needs the sensor's full settle time regardless of this value.
+func WithAddressChangeDelay(d time.Duration) Option {
+	return func(t *T67XX) error {
+		t.addressChangeDelay = d
+		return nil
+	}
+}
+
+// WithWarmupSource selects how the driver measures sensor warm-up. Defaults
+// to WarmupSourceDevice.
+func WithWarmupSource(source WarmupSource) Option {
+	return func(t *T67XX) error {
+		t.warmupSource = source
+		return nil
+	}
+}
+
+// WithClock overrides the function the driver uses to read the current
+// time. Intended for deterministic tests; defaults to time.Now.
+func WithClock(clock func() time.Time) Option {
+	return func(t *T67XX) error {
+		t.clock = clock
+		return nil
+	}
+}
+
+// New constructs a T67XX that talks to the sensor over bus, applying opts in
+// order. The default logger writes through slog.Default(); pass WithLogger
+// or WithSlog to override it.
+func New(bus Bus, opts ...Option) (*T67XX, error) {
+	t := &T67XX{
+		Device: bus,
+		log:    slogLogger{logger: slog.Default()},
+	}
+
+	for _, opt := range opts {
+		if err := opt(t); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
@@ -0,0 +1,81 @@
+package t67xx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wjessop/t67xx/t67xxtest"
+)
+
+func TestNewDefaults(t *testing.T) {
+	bus := t67xxtest.New(nil)
+
+	driver, err := New(bus)
+	if err != nil {
+		t.Fatalf("New returned an error: %v", err)
+	}
+	if driver.Device != bus {
+		t.Fatal("expected New to set Device to the given bus")
+	}
+	if driver.log == nil {
+		t.Fatal("expected New to set a default logger")
+	}
+}
+
+func TestNewWithAddressOutOfRange(t *testing.T) {
+	bus := t67xxtest.New(nil)
+
+	_, err := New(bus, WithAddress(0x01))
+	if _, ok := err.(*ErrAddressOutOfRange); !ok {
+		t.Fatalf("expected an *ErrAddressOutOfRange, got %T: %v", err, err)
+	}
+}
+
+func TestNewWithOptions(t *testing.T) {
+	bus := t67xxtest.New(nil)
+	fixed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	driver, err := New(
+		bus,
+		WithAddress(0x22),
+		WithCommandDelay(time.Millisecond),
+		WithCalibrationPollInterval(5*time.Millisecond),
+		WithAddressChangeDelay(5*time.Millisecond),
+		WithWarmupSource(WarmupSourceSystemBoot),
+		WithClock(func() time.Time { return fixed }),
+	)
+	if err != nil {
+		t.Fatalf("New returned an error: %v", err)
+	}
+
+	if driver.address != 0x22 {
+		t.Fatalf("expected address 0x22, got 0x%x", driver.address)
+	}
+	if driver.Address() != 0x22 {
+		t.Fatalf("expected Address() to return 0x22, got 0x%x", driver.Address())
+	}
+	if driver.commandDelay != time.Millisecond {
+		t.Fatalf("expected command delay of 1ms, got %s", driver.commandDelay)
+	}
+	if driver.calibrationPollInterval != 5*time.Millisecond {
+		t.Fatalf("expected calibration poll interval of 5ms, got %s", driver.calibrationPollInterval)
+	}
+	if driver.addressChangeDelay != 5*time.Millisecond {
+		t.Fatalf("expected address change delay of 5ms, got %s", driver.addressChangeDelay)
+	}
+	if driver.warmupSource != WarmupSourceSystemBoot {
+		t.Fatalf("expected WarmupSourceSystemBoot, got %v", driver.warmupSource)
+	}
+	if got := driver.now(); !got.Equal(fixed) {
+		t.Fatalf("expected the injected clock to be used, got %v", got)
+	}
+}
+
+func TestSetAddressOutOfRange(t *testing.T) {
+	driver, _ := newTestDriver(nil)
+
+	err := driver.SetAddress(0x01)
+	if _, ok := err.(*ErrAddressOutOfRange); !ok {
+		t.Fatalf("expected an *ErrAddressOutOfRange, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,156 @@
+package t67xx
+
+import (
+	"context"
+	"time"
+)
+
+// ReadingFlag enumerates conditions attached to a Reading beyond its raw ppm
+// value.
+type ReadingFlag int
+
+const (
+	// FlagWarmup marks a reading taken while the sensor was still within its
+	// warm-up window, so it may not be accurate yet.
+	FlagWarmup ReadingFlag = 1 << iota
+	// FlagOutlier marks a reading whose ppm fell outside the plausible range
+	// configured in StreamOptions.
+	FlagOutlier
+	// FlagStatusError marks a reading taken while the sensor's status
+	// register reported an error condition.
+	FlagStatusError
+)
+
+// HasFlag reports whether any bit of test is set in f.
+func (f ReadingFlag) HasFlag(test ReadingFlag) bool {
+	return f&test != 0
+}
+
+// Reading is a single sample produced by Stream.
+type Reading struct {
+	PPM    int
+	Time   time.Time
+	Status Bitmask
+	Flags  ReadingFlag
+}
+
+// StreamOptions configures Stream.
+type StreamOptions struct {
+	// Interval is how often to sample the sensor. Defaults to one second.
+	Interval time.Duration
+
+	// MinPPM and MaxPPM mark the plausible CO₂ range used for outlier
+	// tagging. A reading outside this range is still delivered, tagged with
+	// FlagOutlier, rather than dropped. Leave either at zero to disable that
+	// bound.
+	MinPPM, MaxPPM int
+
+	// SmoothingWindow, if greater than 1, smooths delivered ppm values with a
+	// rolling mean over the last SmoothingWindow raw readings. Outlier
+	// tagging is still based on the raw, unsmoothed reading.
+	SmoothingWindow int
+
+	// SuppressWarmup, if true, causes Stream to omit readings taken while the
+	// sensor is still warming up instead of delivering them with FlagWarmup
+	// set.
+	SuppressWarmup bool
+}
+
+func (o StreamOptions) interval() time.Duration {
+	if o.Interval <= 0 {
+		return time.Second
+	}
+	return o.Interval
+}
+
+// Stream samples the sensor at opts.Interval, delivering a Reading per
+// sample on the returned channel until ctx is done, at which point the
+// channel is closed and sampling stops.
+func (t *T67XX) Stream(ctx context.Context, opts StreamOptions) (<-chan Reading, error) {
+	out := make(chan Reading)
+
+	go func() {
+		defer close(out)
+
+		ticker := time.NewTicker(opts.interval())
+		defer ticker.Stop()
+
+		var window []int
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reading, ok := t.sample(opts, &window)
+				if !ok {
+					continue
+				}
+
+				select {
+				case out <- reading:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (t *T67XX) sample(opts StreamOptions, window *[]int) (Reading, bool) {
+	ppm, err := t.GasPPM()
+	if err != nil {
+		t.log.Debugf("Stream: error reading gas ppm: %v", err)
+		return Reading{}, false
+	}
+
+	status, err := t.Status()
+	if err != nil {
+		t.log.Debugf("Stream: error reading status: %v", err)
+		return Reading{}, false
+	}
+
+	var flags ReadingFlag
+
+	atFullAccuracy, err := t.SensorIsAtFullAccuracy()
+	if err != nil {
+		t.log.Debugf("Stream: error checking warm-up state: %v", err)
+		return Reading{}, false
+	}
+	if !atFullAccuracy {
+		if opts.SuppressWarmup {
+			return Reading{}, false
+		}
+		flags |= FlagWarmup
+	}
+
+	if status.IsSet(statusBitErrorCondition) {
+		flags |= FlagStatusError
+	}
+
+	if (opts.MinPPM != 0 && ppm < opts.MinPPM) || (opts.MaxPPM != 0 && ppm > opts.MaxPPM) {
+		flags |= FlagOutlier
+	}
+
+	if opts.SmoothingWindow > 1 {
+		*window = append(*window, ppm)
+		if len(*window) > opts.SmoothingWindow {
+			*window = (*window)[len(*window)-opts.SmoothingWindow:]
+		}
+
+		sum := 0
+		for _, v := range *window {
+			sum += v
+		}
+		ppm = sum / len(*window)
+	}
+
+	return Reading{
+		PPM:    ppm,
+		Time:   t.now(),
+		Status: status,
+		Flags:  flags,
+	}, true
+}
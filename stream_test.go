@@ -0,0 +1,166 @@
+package t67xx
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/wjessop/t67xx/t67xxtest"
+)
+
+func TestStream(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		// Tick 1: a normal reading.
+		{
+			Write: []byte{0x04, 0x13, 0x8b, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x01, 0xf4, 0xa0, 0xc3}, // 500 ppm
+		},
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x00, 0x00, 0xa0, 0xd4}, // no status bits set
+		},
+		// Tick 2: an out-of-range reading.
+		{
+			Write: []byte{0x04, 0x13, 0x8b, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x17, 0x70, 0xae, 0xc0}, // 6000 ppm
+		},
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x00, 0x00, 0xa0, 0xd4},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	readings, err := driver.Stream(ctx, StreamOptions{
+		Interval: time.Millisecond,
+		MinPPM:   200,
+		MaxPPM:   5000,
+	})
+	if err != nil {
+		t.Fatalf("Stream returned an error: %v", err)
+	}
+
+	first := <-readings
+	if first.PPM != 500 {
+		t.Fatalf("expected first reading of 500 ppm, got %d", first.PPM)
+	}
+	if first.Flags.HasFlag(FlagOutlier) {
+		t.Fatal("did not expect the first reading to be flagged as an outlier")
+	}
+
+	second := <-readings
+	if second.PPM != 6000 {
+		t.Fatalf("expected second reading of 6000 ppm, got %d", second.PPM)
+	}
+	if !second.Flags.HasFlag(FlagOutlier) {
+		t.Fatal("expected the second reading to be flagged as an outlier")
+	}
+
+	cancel()
+
+	if _, ok := <-readings; ok {
+		t.Fatal("expected the readings channel to be closed after cancelling the context")
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStreamSuppressWarmup(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		{
+			Write: []byte{0x04, 0x13, 0x8b, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x01, 0xf4, 0xa0, 0xc3}, // 500 ppm
+		},
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x00, 0x00, 0xa0, 0xd4}, // no status bits set
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	readings, err := driver.Stream(ctx, StreamOptions{
+		Interval:       5 * time.Millisecond,
+		SuppressWarmup: true,
+	})
+	if err != nil {
+		t.Fatalf("Stream returned an error: %v", err)
+	}
+
+	select {
+	case r := <-readings:
+		t.Fatalf("expected no reading while the sensor is warming up, got %+v", r)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	cancel()
+
+	if _, ok := <-readings; ok {
+		t.Fatal("expected the readings channel to be closed after cancelling the context")
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStreamSmoothingWindow(t *testing.T) {
+	driver, bus := newTestDriver([]t67xxtest.Transaction{
+		// Tick 1: 400 ppm.
+		{
+			Write: []byte{0x04, 0x13, 0x8b, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x01, 0x90, 0xa1, 0x28},
+		},
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x00, 0x00, 0xa0, 0xd4},
+		},
+		// Tick 2: 600 ppm.
+		{
+			Write: []byte{0x04, 0x13, 0x8b, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x02, 0x58, 0xa0, 0x4e},
+		},
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x00, 0x00, 0xa0, 0xd4},
+		},
+		// Tick 3: 800 ppm.
+		{
+			Write: []byte{0x04, 0x13, 0x8b, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x03, 0x20, 0xa1, 0xfc},
+		},
+		{
+			Write: []byte{0x04, 0x13, 0x8a, 0x00, 0x01},
+			Read:  []byte{0x04, 0x02, 0x00, 0x00, 0xa0, 0xd4},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	readings, err := driver.Stream(ctx, StreamOptions{
+		Interval:        time.Millisecond,
+		SmoothingWindow: 2,
+	})
+	if err != nil {
+		t.Fatalf("Stream returned an error: %v", err)
+	}
+
+	for _, want := range []int{400, 500, 700} {
+		if r := <-readings; r.PPM != want {
+			t.Fatalf("expected a smoothed reading of %d ppm, got %d", want, r.PPM)
+		}
+	}
+
+	cancel()
+
+	if _, ok := <-readings; ok {
+		t.Fatal("expected the readings channel to be closed after cancelling the context")
+	}
+	if err := bus.Done(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,82 @@
+// Package t67xxtest provides a recorded-transaction playback implementation
+// of t67xx.Bus so the driver can be exercised without real hardware.
+package t67xxtest
+
+import "fmt"
+
+// Transaction is a single expected write/read exchange on the bus. Every
+// driver command, Reset included, writes a request and then reads a
+// response, and the Bus only moves on to the next Transaction after a Read,
+// so each Transaction needs both Write and Read.
+type Transaction struct {
+	Write []byte
+	Read  []byte
+}
+
+// Bus replays a fixed, ordered list of Transactions, failing any call whose
+// bytes don't match what's expected next.
+type Bus struct {
+	transactions []Transaction
+	pos          int
+}
+
+// New returns a playback Bus that will serve transactions in order.
+func New(transactions []Transaction) *Bus {
+	return &Bus{transactions: transactions}
+}
+
+// Write checks p against the next expected transaction's write bytes.
+func (b *Bus) Write(p []byte) error {
+	if b.pos >= len(b.transactions) {
+		return fmt.Errorf("t67xxtest: unexpected write %#v, no transactions left", p)
+	}
+
+	want := b.transactions[b.pos].Write
+	if !bytesEqual(want, p) {
+		return fmt.Errorf("t67xxtest: write %#v at transaction %d did not match expected %#v", p, b.pos, want)
+	}
+
+	return nil
+}
+
+// Read copies the next expected transaction's read bytes into p, advancing
+// to the following transaction.
+func (b *Bus) Read(p []byte) error {
+	if b.pos >= len(b.transactions) {
+		return fmt.Errorf("t67xxtest: unexpected read of %d bytes, no transactions left", len(p))
+	}
+
+	want := b.transactions[b.pos].Read
+	if len(p) != len(want) {
+		return fmt.Errorf("t67xxtest: read of %d bytes at transaction %d did not match expected length %d", len(p), b.pos, len(want))
+	}
+
+	copy(p, want)
+	b.pos++
+	return nil
+}
+
+// Close is a no-op, satisfying t67xx.Bus.
+func (b *Bus) Close() error {
+	return nil
+}
+
+// Done returns an error if any transactions were never consumed.
+func (b *Bus) Done() error {
+	if b.pos != len(b.transactions) {
+		return fmt.Errorf("t67xxtest: %d of %d transactions were not consumed", len(b.transactions)-b.pos, len(b.transactions))
+	}
+	return nil
+}
+
+func bytesEqual(a, b []byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
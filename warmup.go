@@ -0,0 +1,161 @@
+package t67xx
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WarmupSource selects how T67XX measures how long the sensor has been
+// warming up.
+type WarmupSource int
+
+const (
+	// WarmupSourceDevice (the default) tracks warm-up from the first
+	// successful command issued through this driver instance, or from the
+	// most recent call to Reset. This is correct regardless of host uptime
+	// and works the same on any OS.
+	WarmupSourceDevice WarmupSource = iota
+
+	// WarmupSourceSystemBoot tracks warm-up from the host's boot time via
+	// /proc/stat, i.e. assumes the sensor has been powered for as long as
+	// the host has been running. Linux-only, and wrong if the sensor was
+	// hot-plugged or the host has been up longer than the sensor has been
+	// powered. Kept for callers relying on the driver's old behaviour.
+	WarmupSourceSystemBoot
+)
+
+const (
+	// OperationalAccuracyDelay is how long after warm-up start the sensor
+	// reaches operational accuracy, per the datasheet.
+	OperationalAccuracyDelay = 120 * time.Second
+
+	// FullAccuracyDelay is how long after warm-up start the sensor reaches
+	// full accuracy, per the datasheet.
+	FullAccuracyDelay = 10 * time.Minute
+)
+
+func (t *T67XX) now() time.Time {
+	if t.clock != nil {
+		return t.clock()
+	}
+	return time.Now()
+}
+
+// recordActivity marks the moment the sensor was first successfully talked
+// to, which anchors WarmupSourceDevice timing. It's a no-op once a start
+// time has already been recorded.
+func (t *T67XX) recordActivity() {
+	if t.warmupStart.IsZero() {
+		t.warmupStart = t.now()
+	}
+}
+
+// warmupElapsed returns how long the sensor has been warming up for,
+// according to t.warmupSource.
+func (t *T67XX) warmupElapsed() (time.Duration, error) {
+	if t.warmupSource == WarmupSourceSystemBoot {
+		bootTime, err := t.secondsSinceSystemBoot()
+		if err != nil {
+			return 0, errors.Wrap(err, "could not get seconds since system boot")
+		}
+		return t.now().Sub(time.Unix(bootTime, 0)), nil
+	}
+
+	if t.warmupStart.IsZero() {
+		return 0, nil
+	}
+	return t.now().Sub(t.warmupStart), nil
+}
+
+// OperationalAccuracyReached reports whether the sensor has been warming up
+// for at least OperationalAccuracyDelay.
+//
+// From the datasheet:
+//
+//   "The sensor is capable of responding to commands after power on, but
+//    operational accuracy of sensor won't happen until 120 sec have elapsed.
+//    The sensor will reach full accuracy / warm up after 10 min. of
+//    operation."
+func (t *T67XX) OperationalAccuracyReached() (bool, error) {
+	elapsed, err := t.warmupElapsed()
+	if err != nil {
+		return false, err
+	}
+	return elapsed >= OperationalAccuracyDelay, nil
+}
+
+// FullAccuracyReached reports whether the sensor has been warming up for at
+// least FullAccuracyDelay.
+func (t *T67XX) FullAccuracyReached() (bool, error) {
+	elapsed, err := t.warmupElapsed()
+	if err != nil {
+		return false, err
+	}
+	return elapsed >= FullAccuracyDelay, nil
+}
+
+// SensorIsAtFullAccuracy returns true if the sensor has reached its full
+// accuracy, or false otherwise. It's equivalent to FullAccuracyReached, kept
+// as the existing name used elsewhere in the driver.
+func (t *T67XX) SensorIsAtFullAccuracy() (bool, error) {
+	return t.FullAccuracyReached()
+}
+
+// SleepUntilFullAccuracy blocks until the sensor has been warming up for
+// FullAccuracyDelay, or until ctx is done. If the sensor has already reached
+// full accuracy it returns immediately.
+func (t *T67XX) SleepUntilFullAccuracy(ctx context.Context) error {
+	elapsed, err := t.warmupElapsed()
+	if err != nil {
+		return errors.Wrap(err, "could not determine how long the sensor has been warming up for")
+	}
+
+	remaining := FullAccuracyDelay - elapsed
+	if remaining <= 0 {
+		t.log.Debug("Sensor has already reached full accuracy")
+		return nil
+	}
+
+	t.log.Debugf("Sensor will reach full accuracy in %s", remaining)
+
+	timer := time.NewTimer(remaining)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// secondsSinceSystemBoot reads /proc/stat's btime, the Unix timestamp the
+// host booted at. Only works on Linux.
+func (t *T67XX) secondsSinceSystemBoot() (int64, error) {
+	file, err := os.Open("/proc/stat")
+	if err != nil {
+		t.log.Debug("Could not read /proc/stat: ", err)
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "btime") {
+			return strconv.ParseInt(strings.TrimSpace(line[6:]), 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, errors.New("btime not found in /proc/stat")
+}
@@ -0,0 +1,78 @@
+package t67xx
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWarmupDeviceSource(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	driver := &T67XX{log: testLogger{}, clock: func() time.Time { return now }}
+
+	driver.recordActivity()
+
+	now = start.Add(119 * time.Second)
+	if reached, err := driver.OperationalAccuracyReached(); err != nil || reached {
+		t.Fatalf("expected operational accuracy not yet reached at 119s, got %v, %v", reached, err)
+	}
+
+	now = start.Add(120 * time.Second)
+	if reached, err := driver.OperationalAccuracyReached(); err != nil || !reached {
+		t.Fatalf("expected operational accuracy reached at 120s, got %v, %v", reached, err)
+	}
+
+	now = start.Add(9*time.Minute + 59*time.Second)
+	if reached, err := driver.FullAccuracyReached(); err != nil || reached {
+		t.Fatalf("expected full accuracy not yet reached, got %v, %v", reached, err)
+	}
+
+	now = start.Add(10 * time.Minute)
+	if reached, err := driver.FullAccuracyReached(); err != nil || !reached {
+		t.Fatalf("expected full accuracy reached at 10m, got %v, %v", reached, err)
+	}
+}
+
+func TestRecordActivityDoesNotResetStart(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	driver := &T67XX{log: testLogger{}, clock: func() time.Time { return now }}
+
+	driver.recordActivity()
+	now = start.Add(time.Minute)
+	driver.recordActivity() // should be a no-op, warmupStart already set
+
+	if !driver.warmupStart.Equal(start) {
+		t.Fatalf("expected warmupStart to remain %v, got %v", start, driver.warmupStart)
+	}
+}
+
+func TestSleepUntilFullAccuracyAlreadyReached(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	driver := &T67XX{
+		log:         testLogger{},
+		warmupStart: start,
+		clock:       func() time.Time { return start.Add(11 * time.Minute) },
+	}
+
+	if err := driver.SleepUntilFullAccuracy(context.Background()); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
+
+func TestSleepUntilFullAccuracyCancel(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	driver := &T67XX{
+		log:         testLogger{},
+		warmupStart: start,
+		clock:       func() time.Time { return start },
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := driver.SleepUntilFullAccuracy(ctx); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}